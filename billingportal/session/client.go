@@ -0,0 +1,38 @@
+// Package session provides the /billing_portal/sessions APIs
+package session
+
+import (
+	"net/url"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// Client is used to invoke /billing_portal/sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new Billing Portal Session.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions/create.
+func New(params *stripe.BillingPortalSessionParams) (*stripe.BillingPortalSession, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.BillingPortalSessionParams) (*stripe.BillingPortalSession, error) {
+	body := &url.Values{
+		"customer":   {params.Customer},
+		"return_url": {params.ReturnURL},
+	}
+
+	params.AppendTo(body)
+
+	session := &stripe.BillingPortalSession{}
+	err := c.B.Call("POST", "/billing_portal/sessions", c.Key, body, session)
+
+	return session, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(), stripe.Key}
+}
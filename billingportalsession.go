@@ -0,0 +1,21 @@
+package stripe
+
+// BillingPortalSessionParams is the set of parameters that can be used
+// when creating a Billing Portal Session.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions/create.
+type BillingPortalSessionParams struct {
+	Params
+	Customer  string
+	ReturnURL string
+}
+
+// BillingPortalSession is the resource representing a Stripe Billing
+// Portal Session.
+// For more details see https://stripe.com/docs/api/customer_portal/sessions.
+type BillingPortalSession struct {
+	ID        string `json:"id"`
+	Live      bool   `json:"livemode"`
+	Customer  string `json:"customer"`
+	ReturnURL string `json:"return_url"`
+	URL       string `json:"url"`
+}
@@ -0,0 +1,83 @@
+// Package session provides the /checkout/sessions APIs
+package session
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+const (
+	Payment      stripe.CheckoutSessionMode = "payment"
+	Setup        stripe.CheckoutSessionMode = "setup"
+	Subscription stripe.CheckoutSessionMode = "subscription"
+)
+
+// Client is used to invoke /checkout/sessions APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/create.
+func New(params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	body := &url.Values{
+		"mode":        {string(params.Mode)},
+		"success_url": {params.SuccessURL},
+		"cancel_url":  {params.CancelURL},
+	}
+
+	for i, li := range params.LineItems {
+		body.Add(fmt.Sprintf("line_items[%v][price]", i), li.Price)
+
+		if li.Quantity > 0 {
+			body.Add(fmt.Sprintf("line_items[%v][quantity]", i), strconv.FormatUint(li.Quantity, 10))
+		}
+	}
+
+	if len(params.Customer) > 0 {
+		body.Add("customer", params.Customer)
+	}
+
+	if len(params.ClientReferenceID) > 0 {
+		body.Add("client_reference_id", params.ClientReferenceID)
+	}
+
+	params.AppendTo(body)
+
+	session := &stripe.CheckoutSession{}
+	err := c.B.Call("POST", "/checkout/sessions", c.Key, body, session)
+
+	return session, err
+}
+
+// Get returns the details of a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/retrieve.
+func Get(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.CheckoutSessionParams) (*stripe.CheckoutSession, error) {
+	var body *url.Values
+
+	if params != nil {
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	session := &stripe.CheckoutSession{}
+	err := c.B.Call("GET", "/checkout/sessions/"+id, c.Key, body, session)
+
+	return session, err
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(), stripe.Key}
+}
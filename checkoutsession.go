@@ -0,0 +1,39 @@
+package stripe
+
+// CheckoutSessionMode is the list of allowed values for a Checkout
+// Session's mode. Allowed values are "payment", "setup", "subscription".
+type CheckoutSessionMode string
+
+// CheckoutSessionLineItemParams is the set of parameters describing a
+// single line item to sell through a Checkout Session.
+type CheckoutSessionLineItemParams struct {
+	Price    string
+	Quantity uint64
+}
+
+// CheckoutSessionParams is the set of parameters that can be used when
+// creating a Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions/create.
+type CheckoutSessionParams struct {
+	Params
+	Mode              CheckoutSessionMode
+	LineItems         []*CheckoutSessionLineItemParams
+	SuccessURL        string
+	CancelURL         string
+	Customer          string
+	ClientReferenceID string
+}
+
+// CheckoutSession is the resource representing a Stripe Checkout Session.
+// For more details see https://stripe.com/docs/api/checkout/sessions.
+type CheckoutSession struct {
+	ID                string              `json:"id"`
+	Live              bool                `json:"livemode"`
+	Mode              CheckoutSessionMode `json:"mode"`
+	Customer          string              `json:"customer"`
+	ClientReferenceID string              `json:"client_reference_id"`
+	SuccessURL        string              `json:"success_url"`
+	CancelURL         string              `json:"cancel_url"`
+	Sub               string              `json:"subscription"`
+	URL               string              `json:"url"`
+}
@@ -0,0 +1,254 @@
+package stripe
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mathrand "math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiURL is the base URL every request is issued against.
+const apiURL = "https://api.stripe.com/v1"
+
+// Backend is an interface for making calls against the Stripe API.
+type Backend interface {
+	Call(method, path, key string, body *url.Values, v interface{}) error
+}
+
+// BackendConfig is used to configure a Backend created with NewBackend.
+// Any zero-valued field falls back to its default.
+type BackendConfig struct {
+	// HTTPClient is the client used to issue requests. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request is retried after a
+	// network error, an HTTP 429, or an HTTP 5xx response. Defaults to 3.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff applied
+	// between retries, before jitter. Default to 500ms and 8s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// IdempotencyKeyFunc generates the Idempotency-Key header attached to
+	// every non-GET request. The same key is reused across retries of a
+	// single call so that Stripe collapses any duplicates it receives.
+	// Defaults to a random 16-byte hex string.
+	IdempotencyKeyFunc func() string
+}
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 8 * time.Second
+)
+
+var (
+	backend   Backend
+	backendMu sync.Mutex
+
+	// Key is the Stripe secret key used to authenticate requests made
+	// through the package-level helpers (New, Get, etc. in each resource
+	// package). Set it once during program initialization.
+	Key string
+)
+
+// GetBackend returns the Backend used by every resource client, creating
+// the default HTTP-backed implementation on first use.
+func GetBackend() Backend {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+
+	if backend == nil {
+		backend = NewBackend(&BackendConfig{})
+	}
+
+	return backend
+}
+
+// SetBackend overrides the Backend used by every resource client. It's
+// primarily useful for tests that want to stub out network calls.
+func SetBackend(b Backend) {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+
+	backend = b
+}
+
+// NewBackend returns a Backend configured per config, retrying failed
+// requests with exponential backoff and attaching an idempotency key to
+// every non-GET request.
+func NewBackend(config *BackendConfig) Backend {
+	b := &backendImplementation{
+		httpClient:         http.DefaultClient,
+		maxRetries:         defaultMaxRetries,
+		minBackoff:         defaultMinBackoff,
+		maxBackoff:         defaultMaxBackoff,
+		idempotencyKeyFunc: newIdempotencyKey,
+	}
+
+	if config != nil {
+		if config.HTTPClient != nil {
+			b.httpClient = config.HTTPClient
+		}
+		if config.MaxRetries > 0 {
+			b.maxRetries = config.MaxRetries
+		}
+		if config.MinBackoff > 0 {
+			b.minBackoff = config.MinBackoff
+		}
+		if config.MaxBackoff > 0 {
+			b.maxBackoff = config.MaxBackoff
+		}
+		if config.IdempotencyKeyFunc != nil {
+			b.idempotencyKeyFunc = config.IdempotencyKeyFunc
+		}
+	}
+
+	return b
+}
+
+// backendImplementation is the default HTTP-backed Backend.
+type backendImplementation struct {
+	httpClient         *http.Client
+	maxRetries         int
+	minBackoff         time.Duration
+	maxBackoff         time.Duration
+	idempotencyKeyFunc func() string
+}
+
+// Call issues an API request, retrying on network errors, HTTP 429s and
+// HTTP 5xxs with exponential backoff and jitter. The same idempotency key
+// is reused across every attempt of a given call. Callers can pin their
+// own key instead of the generated one by setting "idempotency_key" on
+// body (e.g. via Params.IdempotencyKey in AppendTo); the key is stripped
+// out of the form body before the request is sent.
+func (s *backendImplementation) Call(method, path, key string, body *url.Values, v interface{}) error {
+	idempotencyKey := ""
+	if method != "GET" {
+		idempotencyKey = s.idempotencyKeyFunc()
+
+		if body != nil {
+			if override := body.Get("idempotency_key"); len(override) > 0 {
+				idempotencyKey = override
+				body.Del("idempotency_key")
+			}
+		}
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(s.backoff(attempt))
+		}
+
+		res, resBody, err := s.do(method, path, key, idempotencyKey, body)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if shouldRetry(res.StatusCode) && attempt < s.maxRetries {
+			lastErr = fmt.Errorf("stripe: request failed with status %v, retrying", res.StatusCode)
+			continue
+		}
+
+		if res.StatusCode >= 400 {
+			return fmt.Errorf("stripe: request failed with status %v: %s", res.StatusCode, resBody)
+		}
+
+		if v == nil {
+			return nil
+		}
+
+		return json.Unmarshal(resBody, v)
+	}
+
+	return lastErr
+}
+
+// do issues a single HTTP attempt and returns the response along with its
+// fully-read body.
+func (s *backendImplementation) do(method, path, key, idempotencyKey string, body *url.Values) (*http.Response, []byte, error) {
+	var reqBody io.Reader
+	reqURL := apiURL + path
+
+	if body != nil {
+		if method == "GET" {
+			reqURL += "?" + body.Encode()
+		} else {
+			reqBody = strings.NewReader(body.Encode())
+		}
+	}
+
+	req, err := http.NewRequest(method, reqURL, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.SetBasicAuth(key, "")
+	if method != "GET" {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, resBody, nil
+}
+
+// shouldRetry reports whether a response with the given status code
+// should be retried.
+func shouldRetry(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before the given retry attempt (1-indexed),
+// exponential in attempt and capped at maxBackoff, with up to 50% jitter.
+// Doubling stops as soon as maxBackoff is reached instead of shifting by
+// attempt directly, so a large caller-configured MaxRetries can't
+// overflow the duration into a negative number.
+func (s *backendImplementation) backoff(attempt int) time.Duration {
+	d := s.minBackoff
+	for i := 1; i < attempt && d < s.maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > s.maxBackoff {
+		d = s.maxBackoff
+	}
+
+	jitter := time.Duration(mathrand.Int63n(int64(d)/2 + 1))
+
+	return d/2 + jitter
+}
+
+// newIdempotencyKey returns a random 16-byte hex string, the default
+// IdempotencyKeyFunc.
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,64 @@
+package stripe
+
+// CouponDuration is the list of allowed values for a coupon's duration.
+// Allowed values are "forever", "once", "repeating".
+type CouponDuration string
+
+const (
+	Forever   CouponDuration = "forever"
+	Once      CouponDuration = "once"
+	Repeating CouponDuration = "repeating"
+)
+
+// CouponParams is the set of parameters that can be used when creating or
+// updating a coupon.
+// For more details see https://stripe.com/docs/api#create_coupon.
+type CouponParams struct {
+	Params
+	ID               string
+	Duration         CouponDuration
+	AmountOff        uint64
+	Currency         Currency
+	DurationInMonths uint64
+	MaxRedemptions   uint64
+	PercentOff       float64
+	RedeemBy         int64
+}
+
+// CouponListParams is the set of parameters that can be used when listing
+// coupons.
+// For more details see https://stripe.com/docs/api#list_coupons.
+type CouponListParams struct {
+	ListParams
+	Created int64
+}
+
+// Coupon is the resource representing a Stripe coupon.
+// For more details see https://stripe.com/docs/api#coupons.
+type Coupon struct {
+	ID               string            `json:"id"`
+	Live             bool              `json:"livemode"`
+	Created          int64             `json:"created"`
+	Duration         CouponDuration    `json:"duration"`
+	AmountOff        uint64            `json:"amount_off"`
+	Currency         Currency          `json:"currency"`
+	DurationInMonths uint64            `json:"duration_in_months"`
+	MaxRedemptions   uint64            `json:"max_redemptions"`
+	PercentOff       float64           `json:"percent_off"`
+	RedeemBy         int64             `json:"redeem_by"`
+	TimesRedeemed    uint64            `json:"times_redeemed"`
+	Valid            bool              `json:"valid"`
+	Meta             map[string]string `json:"metadata"`
+}
+
+// Discount is the resource representing the coupon or promotion code
+// currently applied to a customer or subscription.
+// For more details see https://stripe.com/docs/api#discounts.
+type Discount struct {
+	Coupon        *Coupon `json:"coupon"`
+	Customer      string  `json:"customer"`
+	Start         int64   `json:"start"`
+	End           int64   `json:"end"`
+	Sub           string  `json:"subscription"`
+	PromotionCode string  `json:"promotion_code"`
+}
@@ -1,5 +1,11 @@
 package stripe
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // Event is the resource representing a Stripe event.
 // For more details see https://stripe.com/docs/api#events.
 type Event struct {
@@ -37,6 +43,91 @@ func (e *Event) GetPrevValue(keys ...string) string {
 	return getValue(e.Data.Prev, keys)
 }
 
+// UnmarshalData decodes e.Data.Obj into target, which should be a pointer
+// to the Stripe resource struct the event carries (e.g. &stripe.Sub{}).
+// It's a typed alternative to walking Data.Obj by hand with GetObjValue.
+func (e *Event) UnmarshalData(target interface{}) error {
+	raw, err := json.Marshal(e.Data.Obj)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, target)
+}
+
+// PreviousAttributes decodes e.Data.Prev into target the same way
+// UnmarshalData decodes e.Data.Obj, so handlers can diff old vs new field
+// values without type-asserting through a nil-prone map chain.
+func (e *Event) PreviousAttributes(target interface{}) error {
+	raw, err := json.Marshal(e.Data.Prev)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, target)
+}
+
+// eventDataTypes is the registry driving the typed accessors below: it
+// maps the Event.Type prefixes Stripe uses for each resource to a
+// constructor for the struct that resource's payload decodes into. Each
+// accessor passes its own prefix to decodeAs, which rejects any prefix
+// that isn't registered here.
+var eventDataTypes = map[string]func() interface{}{
+	"customer.subscription.": func() interface{} { return &Sub{} },
+	"invoice.":               func() interface{} { return &Invoice{} },
+	"charge.dispute.":        func() interface{} { return &Dispute{} },
+}
+
+// decodeAs looks up prefix in eventDataTypes and, if e.Type matches it,
+// decodes e.Data.Obj into a freshly constructed instance of the
+// registered type.
+func (e *Event) decodeAs(prefix string) (interface{}, error) {
+	newTarget, ok := eventDataTypes[prefix]
+	if !ok || !strings.HasPrefix(e.Type, prefix) {
+		return nil, fmt.Errorf("stripe: event %v is not a %v event", e.Type, prefix)
+	}
+
+	target := newTarget()
+	if err := e.UnmarshalData(target); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// Subscription decodes e.Data.Obj into a Sub. It returns an error if e is
+// not a customer.subscription.* event.
+func (e *Event) Subscription() (*Sub, error) {
+	target, err := e.decodeAs("customer.subscription.")
+	if err != nil {
+		return nil, err
+	}
+
+	return target.(*Sub), nil
+}
+
+// Invoice decodes e.Data.Obj into an Invoice. It returns an error if e is
+// not an invoice.* event.
+func (e *Event) Invoice() (*Invoice, error) {
+	target, err := e.decodeAs("invoice.")
+	if err != nil {
+		return nil, err
+	}
+
+	return target.(*Invoice), nil
+}
+
+// Dispute decodes e.Data.Obj into a Dispute. It returns an error if e is
+// not a charge.dispute.* event.
+func (e *Event) Dispute() (*Dispute, error) {
+	target, err := e.decodeAs("charge.dispute.")
+	if err != nil {
+		return nil, err
+	}
+
+	return target.(*Dispute), nil
+}
+
 // getValue returns the value from the m map based on the keys.
 func getValue(m map[string]interface{}, keys []string) string {
 	node := m[keys[0]]
@@ -0,0 +1,39 @@
+package stripe
+
+import "net/url"
+
+// Params is the set of parameters common to most API calls.
+type Params struct {
+	// Expand lists relations Stripe should expand inline in the response.
+	Expand []string
+
+	// Extra carries any additional, less common parameters a specific
+	// request doesn't have a dedicated field for.
+	Extra map[string]string
+
+	// IdempotencyKey pins the Idempotency-Key header the Backend attaches
+	// to this request, overriding the key it would otherwise generate.
+	// Reuse the same key across retries of a logically identical request
+	// to let Stripe collapse duplicates.
+	IdempotencyKey string
+}
+
+// AppendTo adds the common parameters to a set of url.Values suitable for
+// an API request body.
+func (p *Params) AppendTo(body *url.Values) {
+	if p == nil {
+		return
+	}
+
+	for _, e := range p.Expand {
+		body.Add("expand[]", e)
+	}
+
+	for k, v := range p.Extra {
+		body.Add(k, v)
+	}
+
+	if len(p.IdempotencyKey) > 0 {
+		body.Add("idempotency_key", p.IdempotencyKey)
+	}
+}
@@ -0,0 +1,84 @@
+package stripe
+
+import "net/url"
+
+// SubStatus is the list of allowed values for a subscription's status.
+// Allowed values are "trialing", "active", "past_due", "canceled", "unpaid".
+type SubStatus string
+
+// CardParams is the set of parameters used to attach a card to a
+// subscription or customer.
+type CardParams struct {
+	Token  string
+	Name   string
+	Number string
+	Month  string
+	Year   string
+	CVC    string
+}
+
+// AppendDetails adds the card's fields to a set of url.Values. If asCard
+// is true the fields are namespaced under "card", matching the shape the
+// subscriptions API expects when a card is passed inline instead of as a
+// token.
+func (c *CardParams) AppendDetails(values *url.Values, asCard bool) {
+	prefix := "source"
+	if asCard {
+		prefix = "card"
+	}
+
+	if len(c.Token) > 0 {
+		values.Add(prefix, c.Token)
+		return
+	}
+
+	values.Add(prefix+"[number]", c.Number)
+	values.Add(prefix+"[exp_month]", c.Month)
+	values.Add(prefix+"[exp_year]", c.Year)
+
+	if len(c.Name) > 0 {
+		values.Add(prefix+"[name]", c.Name)
+	}
+
+	if len(c.CVC) > 0 {
+		values.Add(prefix+"[cvc]", c.CVC)
+	}
+}
+
+// SubParams is the set of parameters that can be used when creating or
+// updating a subscription.
+// For more details see https://stripe.com/docs/api#create_subscription.
+type SubParams struct {
+	Params
+	Customer string
+	Plan     string
+	Coupon   string
+	// PromoCode is a customer-facing promotion code to redeem in place of
+	// an internal Coupon ID. It's ignored when Coupon is also set.
+	PromoCode  string
+	Token      string
+	Card       *CardParams
+	TrialEnd   int64
+	Quantity   uint64
+	FeePercent float64
+	NoProrate  bool
+	EndCancel  bool
+}
+
+// Sub is the resource representing a Stripe subscription.
+// For more details see https://stripe.com/docs/api#subscriptions.
+type Sub struct {
+	ID        string    `json:"id"`
+	Live      bool      `json:"livemode"`
+	Customer  string    `json:"customer"`
+	Plan      string    `json:"plan"`
+	Status    SubStatus `json:"status"`
+	Quantity  uint64    `json:"quantity"`
+	Start     int64     `json:"start"`
+	TrialEnd  int64     `json:"trial_end"`
+	EndCancel bool      `json:"cancel_at_period_end"`
+	Canceled  int64     `json:"canceled_at"`
+	// Discount is the coupon or promotion code resolved onto the
+	// subscription, set from Coupon/PromoCode on create or update.
+	Discount *Discount `json:"discount"`
+}
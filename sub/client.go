@@ -42,6 +42,8 @@ func (c Client) New(params *stripe.SubParams) (*stripe.Sub, error) {
 
 	if len(params.Coupon) > 0 {
 		body.Add("coupon", params.Coupon)
+	} else if len(params.PromoCode) > 0 {
+		body.Add("promotion_code", params.PromoCode)
 	}
 
 	if params.TrialEnd > 0 {
@@ -111,6 +113,8 @@ func (c Client) Update(id string, params *stripe.SubParams) (*stripe.Sub, error)
 
 	if len(params.Coupon) > 0 {
 		body.Add("coupon", params.Coupon)
+	} else if len(params.PromoCode) > 0 {
+		body.Add("promotion_code", params.PromoCode)
 	}
 
 	if params.TrialEnd > 0 {
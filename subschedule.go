@@ -0,0 +1,68 @@
+package stripe
+
+// ProrationBehavior is the list of allowed values for how proration is
+// handled when a subscription schedule transitions between phases.
+// Allowed values are "create_prorations", "none".
+type ProrationBehavior string
+
+// SchedulePlan is a single plan/quantity pair sold during a subscription
+// schedule phase.
+type SchedulePlan struct {
+	Plan     string
+	Quantity uint64
+}
+
+// SubSchedulePhase describes one phase of a subscription schedule: the
+// plans billed during it and when it starts, ends, and transitions.
+type SubSchedulePhase struct {
+	Plans             []*SchedulePlan
+	Coupon            string
+	TrialEnd          int64
+	StartDate         int64
+	EndDate           int64
+	ProrationBehavior ProrationBehavior
+	DefaultTaxRates   []string
+}
+
+// SubScheduleParams is the set of parameters that can be used when
+// creating or updating a subscription schedule.
+// For more details see https://stripe.com/docs/api/subscription_schedules/create.
+type SubScheduleParams struct {
+	Params
+	Customer    string
+	StartDate   int64
+	EndBehavior string
+	Phases      []*SubSchedulePhase
+}
+
+// SubScheduleListParams is the set of parameters that can be used when
+// listing subscription schedules.
+// For more details see https://stripe.com/docs/api/subscription_schedules/list.
+type SubScheduleListParams struct {
+	ListParams
+	Customer string
+}
+
+// SubSchedule is the resource representing a Stripe subscription
+// schedule.
+// For more details see https://stripe.com/docs/api/subscription_schedules.
+type SubSchedule struct {
+	ID          string              `json:"id"`
+	Live        bool                `json:"livemode"`
+	Created     int64               `json:"created"`
+	Customer    string              `json:"customer"`
+	Sub         string              `json:"subscription"`
+	Status      string              `json:"status"`
+	StartDate   int64               `json:"start_date"`
+	EndBehavior string              `json:"end_behavior"`
+	Phases      []*SubSchedulePhase `json:"phases"`
+	CanceledAt  int64               `json:"canceled_at"`
+	ReleasedAt  int64               `json:"released_at"`
+}
+
+// SubScheduleList is a list of subscription schedules as retrieved from a
+// list endpoint.
+type SubScheduleList struct {
+	ListMeta
+	Values []*SubSchedule `json:"data"`
+}
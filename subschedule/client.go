@@ -0,0 +1,233 @@
+// Package subschedule provides the /subscription_schedules APIs
+package subschedule
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// Client is used to invoke /subscription_schedules APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// New POSTs a new subscription schedule.
+// For more details see https://stripe.com/docs/api/subscription_schedules/create.
+func New(params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	return getC().New(params)
+}
+
+func (c Client) New(params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	body := &url.Values{}
+
+	if len(params.Customer) > 0 {
+		body.Add("customer", params.Customer)
+	}
+
+	if params.StartDate > 0 {
+		body.Add("start_date", strconv.FormatInt(params.StartDate, 10))
+	}
+
+	if len(params.EndBehavior) > 0 {
+		body.Add("end_behavior", params.EndBehavior)
+	}
+
+	appendPhases(body, params.Phases)
+	params.AppendTo(body)
+
+	schedule := &stripe.SubSchedule{}
+	err := c.B.Call("POST", "/subscription_schedules", c.Key, body, schedule)
+
+	return schedule, err
+}
+
+// Get returns the details of a subscription schedule.
+// For more details see https://stripe.com/docs/api/subscription_schedules/retrieve.
+func Get(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	return getC().Get(id, params)
+}
+
+func (c Client) Get(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	var body *url.Values
+
+	if params != nil {
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	schedule := &stripe.SubSchedule{}
+	err := c.B.Call("GET", "/subscription_schedules/"+id, c.Key, body, schedule)
+
+	return schedule, err
+}
+
+// Update updates a subscription schedule's properties, most commonly its
+// phases.
+// For more details see https://stripe.com/docs/api/subscription_schedules/update.
+func Update(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	return getC().Update(id, params)
+}
+
+func (c Client) Update(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	body := &url.Values{}
+
+	if len(params.EndBehavior) > 0 {
+		body.Add("end_behavior", params.EndBehavior)
+	}
+
+	appendPhases(body, params.Phases)
+	params.AppendTo(body)
+
+	schedule := &stripe.SubSchedule{}
+	err := c.B.Call("POST", "/subscription_schedules/"+id, c.Key, body, schedule)
+
+	return schedule, err
+}
+
+// Cancel ends a subscription schedule early.
+// For more details see https://stripe.com/docs/api/subscription_schedules/cancel.
+func Cancel(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	return getC().Cancel(id, params)
+}
+
+func (c Client) Cancel(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	var body *url.Values
+
+	if params != nil {
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	schedule := &stripe.SubSchedule{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_schedules/%v/cancel", id), c.Key, body, schedule)
+
+	return schedule, err
+}
+
+// Release detaches a subscription schedule from its subscription without
+// cancelling the subscription, letting it continue on its own.
+// For more details see https://stripe.com/docs/api/subscription_schedules/release.
+func Release(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	return getC().Release(id, params)
+}
+
+func (c Client) Release(id string, params *stripe.SubScheduleParams) (*stripe.SubSchedule, error) {
+	var body *url.Values
+
+	if params != nil {
+		body = &url.Values{}
+		params.AppendTo(body)
+	}
+
+	schedule := &stripe.SubSchedule{}
+	err := c.B.Call("POST", fmt.Sprintf("/subscription_schedules/%v/release", id), c.Key, body, schedule)
+
+	return schedule, err
+}
+
+// List returns a list of subscription schedules.
+// For more details see https://stripe.com/docs/api/subscription_schedules/list.
+func List(params *stripe.SubScheduleListParams) *Iter {
+	return getC().List(params)
+}
+
+func (c Client) List(params *stripe.SubScheduleListParams) *Iter {
+	var body *url.Values
+	var lp *stripe.ListParams
+
+	if params != nil {
+		body = &url.Values{}
+
+		if len(params.Customer) > 0 {
+			body.Add("customer", params.Customer)
+		}
+
+		params.AppendTo(body)
+		lp = &params.ListParams
+	}
+
+	return &Iter{stripe.GetIter(lp, body, func(b url.Values) ([]interface{}, stripe.ListMeta, error) {
+		list := &stripe.SubScheduleList{}
+		err := c.B.Call("GET", "/subscription_schedules", c.Key, &b, list)
+
+		ret := make([]interface{}, len(list.Values))
+		for i, v := range list.Values {
+			ret[i] = v
+		}
+
+		return ret, list.ListMeta, err
+	})}
+}
+
+// appendPhases serializes phases into the nested phases[n][...] form
+// fields the API expects.
+func appendPhases(body *url.Values, phases []*stripe.SubSchedulePhase) {
+	for i, phase := range phases {
+		prefix := fmt.Sprintf("phases[%v]", i)
+
+		for j, p := range phase.Plans {
+			body.Add(fmt.Sprintf("%v[plans][%v][plan]", prefix, j), p.Plan)
+
+			if p.Quantity > 0 {
+				body.Add(fmt.Sprintf("%v[plans][%v][quantity]", prefix, j), strconv.FormatUint(p.Quantity, 10))
+			}
+		}
+
+		if len(phase.Coupon) > 0 {
+			body.Add(prefix+"[coupon]", phase.Coupon)
+		}
+
+		if phase.TrialEnd > 0 {
+			body.Add(prefix+"[trial_end]", strconv.FormatInt(phase.TrialEnd, 10))
+		}
+
+		if phase.StartDate > 0 {
+			body.Add(prefix+"[start_date]", strconv.FormatInt(phase.StartDate, 10))
+		}
+
+		if phase.EndDate > 0 {
+			body.Add(prefix+"[end_date]", strconv.FormatInt(phase.EndDate, 10))
+		}
+
+		if len(phase.ProrationBehavior) > 0 {
+			body.Add(prefix+"[proration_behavior]", string(phase.ProrationBehavior))
+		}
+
+		for k, taxRate := range phase.DefaultTaxRates {
+			body.Add(fmt.Sprintf("%v[default_tax_rates][%v]", prefix, k), taxRate)
+		}
+	}
+}
+
+// Iter is a iterator for list responses.
+type Iter struct {
+	Iter *stripe.Iter
+}
+
+// Next returns the next value in the list.
+func (i *Iter) Next() (*stripe.SubSchedule, error) {
+	s, err := i.Iter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.(*stripe.SubSchedule), err
+}
+
+// Stop returns true if there are no more iterations to be performed.
+func (i *Iter) Stop() bool {
+	return i.Iter.Stop()
+}
+
+// Meta returns the list metadata.
+func (i *Iter) Meta() *stripe.ListMeta {
+	return i.Iter.Meta()
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(), stripe.Key}
+}
@@ -0,0 +1,128 @@
+// Package webhook provides helpers for verifying and parsing webhook events
+// sent by Stripe.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// DefaultTolerance is the default maximum age of the signed timestamp on a
+// webhook, measured against the current time, used by ConstructEvent.
+const DefaultTolerance = 5 * time.Minute
+
+// Errors that can be returned when verifying a webhook signature.
+var (
+	ErrNotSigned        = errors.New("webhook: no Stripe-Signature header provided")
+	ErrInvalidHeader    = errors.New("webhook: Stripe-Signature header is malformed")
+	ErrNoValidSignature = errors.New("webhook: no valid v1 signature found")
+	ErrTooOld           = errors.New("webhook: timestamp is outside of the allowed tolerance")
+)
+
+// ConstructEvent verifies the signature on a webhook payload using the
+// given endpoint secret and, if valid, unmarshals it into a stripe.Event.
+// It rejects events whose signed timestamp is older than DefaultTolerance.
+// For more details see https://stripe.com/docs/webhooks/signatures.
+func ConstructEvent(payload []byte, sigHeader, secret string) (stripe.Event, error) {
+	return ConstructEventWithTolerance(payload, sigHeader, secret, DefaultTolerance)
+}
+
+// ConstructEventWithTolerance is like ConstructEvent but lets the caller
+// configure the maximum age of the signed timestamp. Timestamps in the
+// future are not rejected, and a tolerance of zero or less skips the check.
+func ConstructEventWithTolerance(payload []byte, sigHeader, secret string, tolerance time.Duration) (stripe.Event, error) {
+	var event stripe.Event
+
+	timestamp, signatures, err := parseSigHeader(sigHeader)
+	if err != nil {
+		return event, err
+	}
+
+	expected := computeSignature(timestamp, payload, secret)
+
+	valid := false
+	for _, sig := range signatures {
+		decoded, err := hex.DecodeString(sig)
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(expected, decoded) {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return event, ErrNoValidSignature
+	}
+
+	if tolerance > 0 {
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > tolerance {
+			return event, ErrTooOld
+		}
+	}
+
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
+// parseSigHeader parses the comma-separated list of "t=..." and "v1=..."
+// pairs carried by the Stripe-Signature header.
+func parseSigHeader(sigHeader string) (int64, []string, error) {
+	if len(sigHeader) == 0 {
+		return 0, nil, ErrNotSigned
+	}
+
+	var timestamp int64
+	var signatures []string
+
+	for _, pair := range strings.Split(sigHeader, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return 0, nil, ErrInvalidHeader
+		}
+
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+
+		switch key {
+		case "t":
+			t, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, ErrInvalidHeader
+			}
+			timestamp = t
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp == 0 || len(signatures) == 0 {
+		return 0, nil, ErrInvalidHeader
+	}
+
+	return timestamp, signatures, nil
+}
+
+// computeSignature returns HMAC-SHA256(secret, "<timestamp>.<payload>").
+func computeSignature(timestamp int64, payload []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
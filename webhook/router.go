@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	stripe "github.com/stripe/stripe-go"
+)
+
+// HandlerFunc is invoked by an EventRouter once a dispatched event's
+// Data.Obj has been decoded into the concrete type the handler was
+// registered with.
+type HandlerFunc func(event stripe.Event, obj interface{}) error
+
+// EventRouter dispatches decoded webhook events to handlers registered by
+// Event.Type, so callers don't have to walk Event.Data.Obj by hand for
+// every event they care about.
+type EventRouter struct {
+	handlers map[string][]routerEntry
+}
+
+type routerEntry struct {
+	target  reflect.Type
+	handler HandlerFunc
+}
+
+// NewEventRouter returns an empty EventRouter.
+func NewEventRouter() *EventRouter {
+	return &EventRouter{handlers: make(map[string][]routerEntry)}
+}
+
+// Handle registers fn to be called whenever an event of the given type is
+// dispatched. target is a pointer to the zero value of the struct the
+// event's Data.Obj should be decoded into (e.g. &stripe.Sub{}).
+func (r *EventRouter) Handle(eventType string, target interface{}, fn HandlerFunc) {
+	t := reflect.TypeOf(target)
+	if t == nil || t.Kind() != reflect.Ptr {
+		panic("webhook: target must be a non-nil pointer")
+	}
+
+	r.handlers[eventType] = append(r.handlers[eventType], routerEntry{target: t.Elem(), handler: fn})
+}
+
+// Dispatch decodes event.Data.Obj into the target type registered for
+// event.Type and invokes every matching handler. It's a no-op, returning
+// nil, if no handler was registered for the event's type.
+func (r *EventRouter) Dispatch(event stripe.Event) error {
+	entries, ok := r.handlers[event.Type]
+	if !ok {
+		return nil
+	}
+
+	raw, err := json.Marshal(event.Data.Obj)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		obj := reflect.New(entry.target)
+		if err := json.Unmarshal(raw, obj.Interface()); err != nil {
+			return fmt.Errorf("webhook: decoding %v for event %v: %v", entry.target, event.Type, err)
+		}
+
+		if err := entry.handler(event, obj.Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}